@@ -3,11 +3,13 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/Ishan-Ravindu/qgo/internal/cli"
 	"github.com/Ishan-Ravindu/qgo/internal/config"
 	"github.com/Ishan-Ravindu/qgo/internal/database"
 	"github.com/Ishan-Ravindu/qgo/pkg/dropdown"
+	"github.com/Ishan-Ravindu/qgo/pkg/utils"
 )
 
 func main() {
@@ -17,14 +19,20 @@ func main() {
 			handleError("Error initializing config", err)
 		}
 
-		db, err := database.Connect(cfg.CurrentConnection)
+		conn := cfg.CurrentConnection
+		conn.Password, err = config.ResolvePassword(conn)
+		if err != nil {
+			handleError("Error resolving stored password", err)
+		}
+
+		db, err := database.Connect(conn)
 		if err != nil {
 			handleError("Error connecting to database", err)
 		}
 
 		fmt.Printf("Connected to %s database. Type your SQL queries or '/exit' to quit.\n", cfg.CurrentConnection.Type)
 
-		cli.RunPrompt(db, cfg.CurrentConnection)
+		cli.RunPrompt(db, &cfg)
 
 		err = db.Close()
 		if err != nil {
@@ -51,13 +59,72 @@ func initializeConfig() (config.Config, error) {
 }
 
 func addNewConnection(cfg config.Config) (config.Config, error) {
-	newCfg, err := config.AddNewConnection(cfg)
+	conn, err := promptConnection()
+	if err != nil {
+		return config.Config{}, fmt.Errorf("adding new connection: %w", err)
+	}
+
+	newCfg, err := config.AddConnection(cfg, conn)
 	if err != nil {
 		return config.Config{}, fmt.Errorf("adding new connection: %w", err)
 	}
 	return newCfg, nil
 }
 
+// promptConnection asks the user for a connection name and type, then only
+// the fields the chosen driver actually needs, so e.g. SQLite isn't asked
+// for a host/port/user it has no use for.
+func promptConnection() (config.Connection, error) {
+	var conn config.Connection
+
+	fmt.Print("Enter connection name: ")
+	fmt.Scan(&conn.Name)
+
+	fmt.Printf("Enter database type (%s): ", strings.Join(database.RegisteredNames(), "/"))
+	fmt.Scan(&conn.Type)
+
+	driver, err := database.Lookup(conn.Type)
+	if err != nil {
+		return config.Connection{}, err
+	}
+
+	for _, field := range driver.Fields() {
+		var value string
+		if field.Secret {
+			pw, err := utils.ReadPassword(field.Label + ": ")
+			if err != nil {
+				return config.Connection{}, fmt.Errorf("reading %s: %w", field.Label, err)
+			}
+			value = pw
+		} else {
+			fmt.Printf("%s: ", field.Label)
+			fmt.Scan(&value)
+		}
+		setConnectionField(&conn, field.Key, value)
+	}
+
+	return conn, nil
+}
+
+func setConnectionField(conn *config.Connection, key, value string) {
+	switch key {
+	case "Host":
+		conn.Host = value
+	case "Port":
+		conn.Port = value
+	case "User":
+		conn.User = value
+	case "Password":
+		conn.Password = value
+	case "Database":
+		conn.Database = value
+	case "Instance":
+		conn.Instance = value
+	case "Encrypt":
+		conn.Encrypt = value
+	}
+}
+
 func selectOrAddConnection(cfg config.Config) (config.Config, error) {
 	for {
 		options := createConnectionOptions(cfg)
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Ishan-Ravindu/qgo/internal/database"
+	"github.com/Ishan-Ravindu/qgo/internal/sqlparse"
+
+	"github.com/c-bata/go-prompt"
+)
+
+// schemaInfo is everything the completer knows about the connected database,
+// fetched once when the prompt starts.
+type schemaInfo struct {
+	tables      []string
+	columns     map[string][]string
+	foreignKeys []database.ForeignKey
+	dialect     string
+}
+
+var keywordSuggestions = []prompt.Suggest{
+	{Text: "SELECT", Description: "Retrieve data from the database"},
+	{Text: "FROM", Description: "Specify the table to query"},
+	{Text: "WHERE", Description: "Filter the results"},
+	{Text: "ORDER BY", Description: "Sort the results"},
+	{Text: "GROUP BY", Description: "Group the results"},
+	{Text: "HAVING", Description: "Filter grouped results"},
+	{Text: "JOIN", Description: "Combine rows from two or more tables"},
+}
+
+// completer suggests tables after FROM/JOIN, a table's own columns after
+// `alias.`, join predicates after `JOIN ... ON`, and otherwise falls back to
+// columns of the tables already referenced in the query (or every table and
+// column qgo knows about, if the query doesn't parse yet).
+func completer(d prompt.Document, schema schemaInfo) []prompt.Suggest {
+	word := d.GetWordBeforeCursor()
+	textBeforeCursor := d.TextBeforeCursor()
+
+	// Best-effort: while the user is still typing, the text before the
+	// cursor is rarely a complete, valid statement. A parse failure here
+	// just means the completer falls back to unfiltered suggestions.
+	stmt, _ := sqlparse.Parse(strings.TrimSpace(textBeforeCursor), schema.dialect)
+	aliasToTable := aliasMap(stmt)
+
+	if alias, prefix, ok := strings.Cut(word, "."); ok {
+		table, known := aliasToTable[strings.ToLower(alias)]
+		if !known {
+			table = alias
+		}
+		return prompt.FilterHasPrefix(columnSuggestions(table, schema.columns[table]), prefix, true)
+	}
+
+	switch lastKeyword(textBeforeCursor, word) {
+	case "from", "join":
+		return prompt.FilterHasPrefix(tableSuggestions(schema.tables), word, true)
+	case "on":
+		return prompt.FilterHasPrefix(joinPredicateSuggestions(schema.foreignKeys, aliasToTable), word, true)
+	}
+
+	suggestions := append([]prompt.Suggest{}, keywordSuggestions...)
+	suggestions = append(suggestions, tableSuggestions(schema.tables)...)
+	suggestions = append(suggestions, relevantColumnSuggestions(stmt, schema.columns)...)
+
+	return prompt.FilterHasPrefix(suggestions, word, true)
+}
+
+func aliasMap(stmt *sqlparse.Statement) map[string]string {
+	aliases := make(map[string]string)
+	if stmt == nil {
+		return aliases
+	}
+
+	for _, ref := range stmt.Tables {
+		aliases[strings.ToLower(ref.Name)] = ref.Name
+		if ref.Alias != "" {
+			aliases[strings.ToLower(ref.Alias)] = ref.Name
+		}
+	}
+	return aliases
+}
+
+func relevantColumnSuggestions(stmt *sqlparse.Statement, columns map[string][]string) []prompt.Suggest {
+	if stmt == nil || len(stmt.Tables) == 0 {
+		var suggestions []prompt.Suggest
+		for table, cols := range columns {
+			suggestions = append(suggestions, columnSuggestions(table, cols)...)
+		}
+		return suggestions
+	}
+
+	var suggestions []prompt.Suggest
+	for _, ref := range stmt.Tables {
+		suggestions = append(suggestions, columnSuggestions(ref.Name, columns[ref.Name])...)
+	}
+	return suggestions
+}
+
+func columnSuggestions(table string, cols []string) []prompt.Suggest {
+	suggestions := make([]prompt.Suggest, 0, len(cols))
+	for _, col := range cols {
+		suggestions = append(suggestions, prompt.Suggest{Text: col, Description: fmt.Sprintf("Column in %s", table)})
+	}
+	return suggestions
+}
+
+func tableSuggestions(tables []string) []prompt.Suggest {
+	suggestions := make([]prompt.Suggest, 0, len(tables))
+	for _, table := range tables {
+		suggestions = append(suggestions, prompt.Suggest{Text: table, Description: "Table"})
+	}
+	return suggestions
+}
+
+// joinPredicateSuggestions offers `<table>.<column> = <ref_table>.<ref_column>`
+// style predicates for every foreign key that touches a table already in the
+// query (or every foreign key, if no table in the query is known yet),
+// preferring the alias it was given.
+func joinPredicateSuggestions(foreignKeys []database.ForeignKey, aliasToTable map[string]string) []prompt.Suggest {
+	tableInQuery := make(map[string]bool)
+	aliasOf := make(map[string]string)
+	for key, table := range aliasToTable {
+		tableInQuery[table] = true
+		if key != strings.ToLower(table) {
+			aliasOf[table] = key
+		}
+	}
+	qualifier := func(table string) string {
+		if alias, ok := aliasOf[table]; ok {
+			return alias
+		}
+		return table
+	}
+
+	var suggestions []prompt.Suggest
+	for _, fk := range foreignKeys {
+		if len(tableInQuery) > 0 && !tableInQuery[fk.Table] && !tableInQuery[fk.RefTable] {
+			continue
+		}
+		predicate := fmt.Sprintf("%s.%s = %s.%s", qualifier(fk.Table), fk.Column, qualifier(fk.RefTable), fk.RefColumn)
+		suggestions = append(suggestions, prompt.Suggest{
+			Text:        predicate,
+			Description: "Foreign key join predicate",
+		})
+	}
+	return suggestions
+}
+
+// lastKeyword returns the lowercased word immediately before the one the
+// user is currently typing, e.g. for "select * from u" with word "u" it
+// returns "from".
+func lastKeyword(textBeforeCursor, word string) string {
+	fields := strings.Fields(strings.TrimSuffix(textBeforeCursor, word))
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToLower(fields[len(fields)-1])
+}
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// handleExplain implements the `/explain [analyze] <query>` meta-command.
+// It always runs inside a rolled-back read-only transaction so that
+// `EXPLAIN ANALYZE INSERT/UPDATE/DELETE` cannot leave any side effects behind,
+// even though the outer statement itself is never an actual write.
+func (s *session) handleExplain(rest string) {
+	analyze := false
+	if lower := strings.ToLower(rest); strings.HasPrefix(lower, "analyze ") {
+		analyze = true
+		rest = strings.TrimSpace(rest[len("analyze "):])
+	}
+
+	if rest == "" {
+		fmt.Println("Usage: /explain [analyze] <query>")
+		return
+	}
+
+	tx, err := beginReadOnlyTx(context.Background(), s.db, s.conn.Type)
+	if err != nil {
+		fmt.Println("Error starting read-only transaction:", err)
+		return
+	}
+	defer tx.Rollback()
+
+	switch s.conn.Type {
+	case "postgresql":
+		explainPostgres(tx, rest, analyze)
+	case "mysql":
+		explainMySQL(tx, rest, analyze)
+	default:
+		fmt.Printf("EXPLAIN is not supported for database type: %s\n", s.conn.Type)
+	}
+}
+
+func explainMySQL(tx *sql.Tx, query string, analyze bool) {
+	if analyze {
+		// MySQL renders EXPLAIN ANALYZE as an indented text tree, one row
+		// holding the whole plan rather than a table of rows.
+		var plan string
+		if err := tx.QueryRow("EXPLAIN ANALYZE " + query).Scan(&plan); err != nil {
+			fmt.Println("Error running EXPLAIN ANALYZE:", err)
+			return
+		}
+		printTextPlan(plan)
+		return
+	}
+
+	executeSelectStatement(tx, "EXPLAIN "+query)
+}
+
+func explainPostgres(tx *sql.Tx, query string, analyze bool) {
+	// FORMAT JSON is requested regardless of ANALYZE so the plan can be
+	// parsed into a tree rather than scraped as text.
+	explainQuery := "EXPLAIN (FORMAT JSON) " + query
+	if analyze {
+		explainQuery = "EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON) " + query
+	}
+
+	var raw string
+	if err := tx.QueryRow(explainQuery).Scan(&raw); err != nil {
+		fmt.Println("Error running EXPLAIN:", err)
+		return
+	}
+
+	var plans []pgExplainResult
+	if err := json.Unmarshal([]byte(raw), &plans); err != nil {
+		fmt.Println("Error parsing EXPLAIN output:", err)
+		return
+	}
+	if len(plans) == 0 {
+		fmt.Println("No plan returned.")
+		return
+	}
+
+	printPostgresPlan(plans[0].Plan, 0)
+}
+
+type pgExplainResult struct {
+	Plan pgPlanNode `json:"Plan"`
+}
+
+type pgPlanNode struct {
+	NodeType      string       `json:"Node Type"`
+	PlanRows      float64      `json:"Plan Rows"`
+	ActualRows    *float64     `json:"Actual Rows"`
+	TotalCost     float64      `json:"Total Cost"`
+	ActualTimeEnd *float64     `json:"Actual Total Time"`
+	Plans         []pgPlanNode `json:"Plans"`
+}
+
+func printPostgresPlan(node pgPlanNode, depth int) {
+	indent := strings.Repeat("  ", depth)
+	line := fmt.Sprintf("%s- %s (cost=%.2f rows=%.0f", indent, node.NodeType, node.TotalCost, node.PlanRows)
+	if node.ActualRows != nil {
+		line += fmt.Sprintf(", actual rows=%.0f", *node.ActualRows)
+	}
+	if node.ActualTimeEnd != nil {
+		line += fmt.Sprintf(", actual time=%.3fms", *node.ActualTimeEnd)
+	}
+	line += ")"
+	fmt.Println(line)
+
+	for _, child := range node.Plans {
+		printPostgresPlan(child, depth+1)
+	}
+}
+
+func printTextPlan(plan string) {
+	for _, line := range strings.Split(plan, "\n") {
+		fmt.Println("  " + line)
+	}
+}
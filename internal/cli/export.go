@@ -0,0 +1,272 @@
+package cli
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// handleExport implements `/export csv|json|ndjson <path> [--limit N] [--gzip]`.
+// It re-runs the last query executed in this session inside a fresh
+// read-only transaction and streams the rows straight to path, rather than
+// buffering the whole result set in memory.
+func (s *session) handleExport(args string) {
+	fields := strings.Fields(args)
+	if len(fields) < 2 {
+		fmt.Println("Usage: /export csv|json|ndjson <path> [--limit N] [--gzip]")
+		return
+	}
+
+	format := strings.ToLower(fields[0])
+	if format != "csv" && format != "json" && format != "ndjson" {
+		fmt.Printf("Unsupported export format: %s\n", format)
+		return
+	}
+
+	path := fields[1]
+
+	limit := 0
+	useGzip := false
+	for i := 2; i < len(fields); i++ {
+		switch fields[i] {
+		case "--gzip":
+			useGzip = true
+		case "--limit":
+			i++
+			if i >= len(fields) {
+				fmt.Println("--limit requires a value")
+				return
+			}
+			n, err := strconv.Atoi(fields[i])
+			if err != nil {
+				fmt.Println("Invalid --limit value:", fields[i])
+				return
+			}
+			limit = n
+		default:
+			fmt.Println("Unknown flag:", fields[i])
+			return
+		}
+	}
+
+	if s.lastQuery == "" {
+		fmt.Println("No query has been run yet in this session.")
+		return
+	}
+
+	query := strings.TrimRight(strings.TrimSpace(s.lastQuery), ";")
+	if limit > 0 {
+		query = wrapWithLimit(query, s.conn.Type, limit)
+	}
+
+	tx, err := beginReadOnlyTx(context.Background(), s.db, s.conn.Type)
+	if err != nil {
+		fmt.Println("Error starting read-only transaction:", err)
+		return
+	}
+	defer tx.Rollback()
+
+	file, err := os.Create(path)
+	if err != nil {
+		fmt.Println("Error creating export file:", err)
+		return
+	}
+	defer file.Close()
+
+	var out io.Writer = file
+	if useGzip {
+		gz := gzip.NewWriter(file)
+		defer gz.Close()
+		out = gz
+	}
+
+	rows, err := tx.Query(query)
+	if err != nil {
+		fmt.Println("Error executing query:", err)
+		return
+	}
+	defer rows.Close()
+
+	switch format {
+	case "csv":
+		err = exportCSV(out, rows)
+	case "json":
+		err = exportJSON(out, rows, false)
+	case "ndjson":
+		err = exportJSON(out, rows, true)
+	}
+	if err != nil {
+		fmt.Println("Error exporting rows:", err)
+		return
+	}
+
+	fmt.Printf("Exported to %s\n", path)
+}
+
+// wrapWithLimit wraps query in a subquery that caps it to limit rows,
+// speaking whichever dialect's syntax conn.Type requires: MSSQL has no
+// LIMIT clause and needs TOP instead, applied to the outer SELECT.
+func wrapWithLimit(query, connType string, limit int) string {
+	if connType == "mssql" {
+		return fmt.Sprintf("SELECT TOP (%d) * FROM (%s) AS qgo_export", limit, query)
+	}
+	return fmt.Sprintf("SELECT * FROM (%s) AS qgo_export LIMIT %d", query, limit)
+}
+
+func exportCSV(out io.Writer, rows *sql.Rows) error {
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(out)
+	if err := w.Write(cols); err != nil {
+		return err
+	}
+
+	record := make([]string, len(cols))
+	return scanRows(rows, cols, func(values []interface{}) error {
+		for i, v := range values {
+			record[i] = formatCSVValue(v)
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+		w.Flush()
+		return w.Error()
+	})
+}
+
+// exportJSON writes either a single JSON array (ndjson=false) or one JSON
+// object per line (ndjson=true), encoding one row at a time so the whole
+// result set is never held in memory at once.
+func exportJSON(out io.Writer, rows *sql.Rows, ndjson bool) error {
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(out)
+	first := true
+
+	if !ndjson {
+		if _, err := io.WriteString(out, "["); err != nil {
+			return err
+		}
+	}
+
+	err = scanRows(rows, cols, func(values []interface{}) error {
+		record := make(map[string]interface{}, len(cols))
+		for i, col := range cols {
+			record[col] = values[i]
+		}
+
+		if ndjson {
+			return enc.Encode(record)
+		}
+
+		if !first {
+			if _, err := io.WriteString(out, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		return enc.Encode(record)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !ndjson {
+		if _, err := io.WriteString(out, "]"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanRows drives rows to completion, converting each row into its native
+// Go types (via rows.ColumnTypes) before handing it to fn.
+func scanRows(rows *sql.Rows, cols []string, fn func(values []interface{}) error) error {
+	colTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+
+	dest := make([]interface{}, len(cols))
+	for i := range dest {
+		dest[i] = new(interface{})
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+
+		values := make([]interface{}, len(cols))
+		for i, d := range dest {
+			values[i] = nativeValue(*(d.(*interface{})), colTypes[i])
+		}
+
+		if err := fn(values); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// nativeValue converts a driver value into the Go type that best matches
+// its SQL type, so exports emit numbers/booleans/timestamps in their own
+// native JSON form instead of qgo's interactive-table string fallback.
+func nativeValue(v interface{}, ct *sql.ColumnType) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	if t, ok := v.(time.Time); ok {
+		return t.Format(time.RFC3339Nano)
+	}
+
+	raw, ok := v.([]byte)
+	if !ok {
+		return v
+	}
+
+	s := string(raw)
+	switch strings.ToUpper(ct.DatabaseTypeName()) {
+	case "INT", "INTEGER", "BIGINT", "SMALLINT", "TINYINT", "DECIMAL", "NUMERIC", "FLOAT", "DOUBLE", "REAL":
+		if n, err := strconv.ParseFloat(s, 64); err == nil {
+			return n
+		}
+	case "BOOL", "BOOLEAN":
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b
+		}
+	}
+
+	return s
+}
+
+func formatCSVValue(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	switch val := v.(type) {
+	case bool:
+		return strconv.FormatBool(val)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
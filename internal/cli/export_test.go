@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "github.com/Ishan-Ravindu/qgo/internal/database"
+)
+
+// columnType queries colDecl's declared type from a real driver so
+// ct.DatabaseTypeName() below reflects what a live query would actually
+// report, rather than a hand-rolled stand-in.
+func columnType(t *testing.T, colDecl string) *sql.ColumnType {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("opening in-memory sqlite db: %v", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec("CREATE TABLE t (c " + colDecl + ")"); err != nil {
+		t.Fatalf("creating table: %v", err)
+	}
+
+	rows, err := db.Query("SELECT c FROM t")
+	if err != nil {
+		t.Fatalf("querying: %v", err)
+	}
+	defer rows.Close()
+
+	colTypes, err := rows.ColumnTypes()
+	if err != nil {
+		t.Fatalf("getting column types: %v", err)
+	}
+	return colTypes[0]
+}
+
+func TestNativeValue(t *testing.T) {
+	if got := nativeValue(nil, columnType(t, "TEXT")); got != nil {
+		t.Errorf("nativeValue(nil) = %v, want nil", got)
+	}
+
+	if got := nativeValue([]byte("42"), columnType(t, "INTEGER")); got != float64(42) {
+		t.Errorf("nativeValue(INTEGER) = %v (%T), want float64(42)", got, got)
+	}
+
+	if got := nativeValue([]byte("1"), columnType(t, "BOOLEAN")); got != true {
+		t.Errorf("nativeValue(BOOLEAN) = %v (%T), want true", got, got)
+	}
+
+	if got := nativeValue([]byte("hello"), columnType(t, "TEXT")); got != "hello" {
+		t.Errorf("nativeValue(TEXT) = %v (%T), want %q", got, got, "hello")
+	}
+
+	// A value that doesn't actually parse as its declared type falls back
+	// to the raw string rather than silently dropping it.
+	if got := nativeValue([]byte("not-a-number"), columnType(t, "INTEGER")); got != "not-a-number" {
+		t.Errorf("nativeValue(unparseable INTEGER) = %v (%T), want %q", got, got, "not-a-number")
+	}
+}
+
+func TestFormatCSVValue(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  string
+	}{
+		{nil, ""},
+		{true, "true"},
+		{false, "false"},
+		{3.5, "3.5"},
+		{"hello", "hello"},
+	}
+
+	for _, tt := range tests {
+		if got := formatCSVValue(tt.input); got != tt.want {
+			t.Errorf("formatCSVValue(%v) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/Ishan-Ravindu/qgo/internal/config"
+	"github.com/Ishan-Ravindu/qgo/pkg/utils"
+)
+
+// handlePasswd implements `/passwd`, rotating the stored credential for the
+// current connection without leaving the prompt.
+func (s *session) handlePasswd() {
+	newPassword, err := utils.ReadPassword("Enter new password: ")
+	if err != nil {
+		fmt.Println("Error reading password:", err)
+		return
+	}
+
+	cfg, err := config.RotatePassword(*s.cfg, s.conn.Name, newPassword)
+	if err != nil {
+		fmt.Println("Error rotating password:", err)
+		return
+	}
+
+	*s.cfg = cfg
+	s.conn.Password = newPassword
+	s.conn.Secret = cfg.CurrentConnection.Secret
+
+	fmt.Println("Password updated.")
+}
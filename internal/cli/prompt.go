@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"os"
@@ -8,12 +9,26 @@ import (
 
 	"github.com/Ishan-Ravindu/qgo/internal/config"
 	"github.com/Ishan-Ravindu/qgo/internal/database"
+	"github.com/Ishan-Ravindu/qgo/internal/sqlparse"
 
 	"github.com/c-bata/go-prompt"
 	"github.com/olekukonko/tablewriter"
 )
 
-func RunPrompt(db *sql.DB, currentConnection config.Connection) {
+// session holds everything a single qgo prompt needs across commands: the
+// connection itself, the config it came from (so /passwd can persist a
+// rotated credential), and the last successfully validated query, so that
+// commands like /export can re-run it later.
+type session struct {
+	db        *sql.DB
+	conn      config.Connection
+	cfg       *config.Config
+	lastQuery string
+}
+
+func RunPrompt(db *sql.DB, cfg *config.Config) {
+	currentConnection := cfg.CurrentConnection
+
 	tables, err := database.FetchTables(db, currentConnection.Type)
 	if err != nil {
 		fmt.Println("Error fetching tables:", err)
@@ -30,12 +45,20 @@ func RunPrompt(db *sql.DB, currentConnection config.Connection) {
 		columns[table] = cols
 	}
 
+	foreignKeys, err := database.FetchForeignKeys(db, currentConnection.Type)
+	if err != nil {
+		fmt.Println("Error fetching foreign keys:", err)
+	}
+
+	schema := schemaInfo{tables: tables, columns: columns, foreignKeys: foreignKeys, dialect: currentConnection.Type}
+	s := &session{db: db, conn: currentConnection, cfg: cfg}
+
 	p := prompt.New(
 		func(input string) {
-			executor(db, input)
+			s.executor(input)
 		},
 		func(d prompt.Document) []prompt.Suggest {
-			return completer(d, tables, columns)
+			return completer(d, schema)
 		},
 		prompt.OptionPrefix(fmt.Sprintf("%s@%s:(%s)-> ",
 			currentConnection.User,
@@ -46,40 +69,112 @@ func RunPrompt(db *sql.DB, currentConnection config.Connection) {
 	p.Run()
 }
 
-func executor(db *sql.DB, input string) {
+func (s *session) executor(input string) {
 	input = strings.TrimSpace(input)
 
 	if strings.HasPrefix(input, "/") {
-		handleCommand(input[1:])
+		s.handleCommand(input[1:])
 		return
 	}
-	// This tool is meant to only read database data, so avoid making any changes to the database state.
-	// TODO: Improve the validation logic.
-	if !isValidSelectStatement(input) {
-		fmt.Println("Qgo only supports valid SELECT statements.")
+
+	s.executeReadOnly(input)
+}
+
+func (s *session) handleCommand(cmd string) {
+	fields := strings.Fields(cmd)
+	if len(fields) == 0 {
+		fmt.Println("command not found")
 		return
 	}
 
-	executeSelectStatement(db, input)
-}
+	rest := strings.TrimSpace(strings.TrimPrefix(cmd, fields[0]))
 
-func handleCommand(cmd string) {
-	cmd = strings.ToLower(cmd)
-	if cmd == "exit" {
+	switch strings.ToLower(fields[0]) {
+	case "exit":
 		fmt.Println("Good bye!")
 		os.Exit(0)
-	} else {
+	case "explain":
+		s.handleExplain(rest)
+	case "export":
+		s.handleExport(rest)
+	case "passwd":
+		s.handlePasswd()
+	default:
 		fmt.Println("command not found")
 	}
 }
 
-func isValidSelectStatement(input string) bool {
-	words := strings.Fields(strings.ToLower(input))
-	return len(words) > 0 && words[0] == "select"
+// executeReadOnly validates that query is structurally a SELECT statement
+// and then runs it inside a read-only transaction that is always rolled
+// back. For MySQL and PostgreSQL, sqlparse rejects anything that isn't a
+// SELECT up front; sqlparse has no parser for sqlite or mssql, so for those
+// dialects this structural check is skipped and the transaction (read-only
+// where the driver allows it, and always rolled back) is the sole backstop
+// against a statement that mutates state.
+func (s *session) executeReadOnly(query string) {
+	stmt, err := sqlparse.Parse(query, s.conn.Type)
+	if err != nil {
+		fmt.Println("Error parsing query:", err)
+		return
+	}
+	if !stmt.IsSelect {
+		fmt.Println("Qgo only supports SELECT statements.")
+		return
+	}
+
+	tx, err := beginReadOnlyTx(context.Background(), s.db, s.conn.Type)
+	if err != nil {
+		fmt.Println("Error starting read-only transaction:", err)
+		return
+	}
+	defer tx.Rollback()
+
+	s.lastQuery = query
+
+	executeSelectStatement(tx, query)
+}
+
+// beginReadOnlyTx opens a transaction that is always meant to be rolled back
+// by the caller, enforced as read-only by whatever mechanism dialect
+// actually honors:
+//
+//   - mysql: go-sql-driver/mysql honors TxOptions.ReadOnly natively by
+//     issuing `START TRANSACTION READ ONLY` as part of BeginTx. An earlier
+//     version of this function additionally ran `SET TRANSACTION READ ONLY`
+//     after BeginTx returned, but by then the transaction had already
+//     started and MySQL rejects changing transaction characteristics once
+//     it's in progress (error 1568) — that broke every MySQL query. Do not
+//     reintroduce it.
+//   - mssql: go-mssqldb's BeginTx rejects TxOptions.ReadOnly outright with
+//     "read-only transactions are not supported", so it must not be set.
+//     sqlparse does not check mssql statements either, so the caller's
+//     unconditional rollback is all that undoes a write on this dialect.
+//   - sqlite: modernc.org/sqlite silently ignores TxOptions.ReadOnly, so
+//     `PRAGMA query_only = ON` is issued on the connection instead, which it
+//     does enforce.
+//   - postgresql: honors TxOptions.ReadOnly natively via `SET TRANSACTION
+//     READ ONLY` sent as part of BEGIN, same as mysql.
+func beginReadOnlyTx(ctx context.Context, db *sql.DB, dialect string) (*sql.Tx, error) {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{
+		ReadOnly:  dialect != "mssql",
+		Isolation: sql.LevelRepeatableRead,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if dialect == "sqlite" {
+		if _, err := tx.ExecContext(ctx, "PRAGMA query_only = ON"); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("enabling query_only: %w", err)
+		}
+	}
+
+	return tx, nil
 }
 
-func executeSelectStatement(db *sql.DB, query string) {
-	rows, err := db.Query(query)
+func executeSelectStatement(tx *sql.Tx, query string) {
+	rows, err := tx.Query(query)
 	if err != nil {
 		fmt.Println("Error executing query:", err)
 		return
@@ -93,7 +188,7 @@ func executeSelectStatement(db *sql.DB, query string) {
 	}
 
 	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader(cols)
+	table.Header(cols)
 
 	rawResult := make([][]byte, len(cols))
 	dest := make([]interface{}, len(cols))
@@ -115,32 +210,13 @@ func executeSelectStatement(db *sql.DB, query string) {
 				row[i] = string(raw)
 			}
 		}
-		table.Append(row)
-	}
-
-	table.Render()
-}
-
-func completer(d prompt.Document, tables []string, columns map[string][]string) []prompt.Suggest {
-	suggestions := []prompt.Suggest{
-		{Text: "SELECT", Description: "Retrieve data from the database"},
-		{Text: "FROM", Description: "Specify the table to query"},
-		{Text: "WHERE", Description: "Filter the results"},
-		{Text: "ORDER BY", Description: "Sort the results"},
-		{Text: "GROUP BY", Description: "Group the results"},
-		{Text: "HAVING", Description: "Filter grouped results"},
-		{Text: "JOIN", Description: "Combine rows from two or more tables"},
-	}
-
-	for _, table := range tables {
-		suggestions = append(suggestions, prompt.Suggest{Text: table, Description: "Table"})
-	}
-
-	for table, cols := range columns {
-		for _, col := range cols {
-			suggestions = append(suggestions, prompt.Suggest{Text: col, Description: fmt.Sprintf("Column in %s", table)})
+		if err := table.Append(row); err != nil {
+			fmt.Println("Error appending row:", err)
+			return
 		}
 	}
 
-	return prompt.FilterHasPrefix(suggestions, d.GetWordBeforeCursor(), true)
+	if err := table.Render(); err != nil {
+		fmt.Println("Error rendering results:", err)
+	}
 }
@@ -4,7 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	
+
 	"github.com/Ishan-Ravindu/qgo/pkg/utils"
 )
 
@@ -16,6 +16,9 @@ type Connection struct {
 	User     string
 	Password string
 	Database string
+	Instance string
+	Encrypt  string
+	Secret   SecretRef
 }
 
 type Config struct {
@@ -23,6 +26,15 @@ type Config struct {
 	CurrentConnection Connection
 }
 
+// Field describes one piece of connection information a database driver
+// needs from the user when setting up a new connection. Key must match a
+// field on Connection; callers use it to know which field to populate.
+type Field struct {
+	Key    string
+	Label  string
+	Secret bool
+}
+
 func LoadConfig() (Config, error) {
 	configPath := utils.GetConfigPath()
 	file, err := os.Open(configPath)
@@ -34,8 +46,11 @@ func LoadConfig() (Config, error) {
 	defer file.Close()
 
 	var cfg Config
-	err = json.NewDecoder(file).Decode(&cfg)
-	return cfg, err
+	if err := json.NewDecoder(file).Decode(&cfg); err != nil {
+		return Config{}, err
+	}
+
+	return migrateLegacyPasswords(cfg)
 }
 
 func SaveConfig(cfg Config) error {
@@ -49,30 +64,21 @@ func SaveConfig(cfg Config) error {
 	return json.NewEncoder(file).Encode(cfg)
 }
 
-func AddNewConnection(cfg Config) (Config, error) {
-	var conn Connection
-	fmt.Print("Enter connection name: ")
-	fmt.Scan(&conn.Name)
-	fmt.Print("Enter database type (mysql/postgresql): ")
-	fmt.Scan(&conn.Type)
-	fmt.Print("Enter host: ")
-	fmt.Scan(&conn.Host)
-	fmt.Print("Enter port: ")
-	fmt.Scan(&conn.Port)
-	fmt.Print("Enter username: ")
-	fmt.Scan(&conn.User)
-	fmt.Print("Enter password: ")
-	fmt.Scan(&conn.Password)
-	fmt.Print("Enter database name: ")
-	fmt.Scan(&conn.Database)
+// AddConnection moves conn's password into the active secret backend,
+// appends it to cfg, makes it the current connection, and persists the
+// result. Gathering the connection details themselves is the caller's job,
+// since the fields required depend on the chosen driver.
+func AddConnection(cfg Config, conn Connection) (Config, error) {
+	if err := StoreSecret(&conn); err != nil {
+		return cfg, fmt.Errorf("storing password: %w", err)
+	}
 
 	cfg.Connections = append(cfg.Connections, conn)
 	cfg.CurrentConnection = conn
 
-	err := SaveConfig(cfg)
-	if err != nil {
+	if err := SaveConfig(cfg); err != nil {
 		return cfg, err
 	}
 
 	return cfg, nil
-}
\ No newline at end of file
+}
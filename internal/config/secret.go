@@ -0,0 +1,162 @@
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+// SecretRef is what actually gets persisted to disk in place of a
+// connection's plaintext password: which backend holds it, and under what
+// key.
+type SecretRef struct {
+	Backend string
+	Key     string
+}
+
+// SecretBackend stores and retrieves a single secret value by key. Backends
+// register themselves with RegisterSecretBackend from an init() function.
+type SecretBackend interface {
+	Name() string
+	Store(key, value string) error
+	Resolve(key string) (string, error)
+}
+
+var secretBackends = make(map[string]SecretBackend)
+
+// RegisterSecretBackend makes a SecretBackend available by its Name().
+func RegisterSecretBackend(b SecretBackend) {
+	secretBackends[b.Name()] = b
+}
+
+func secretBackend(name string) (SecretBackend, error) {
+	b, ok := secretBackends[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown secret backend: %s", name)
+	}
+	return b, nil
+}
+
+// defaultSecretBackend is "keyring" unless overridden with QGO_SECRET_BACKEND
+// (set to "plaintext" for CI, or "age" for a passphrase-encrypted file).
+func defaultSecretBackend() string {
+	if name := os.Getenv("QGO_SECRET_BACKEND"); name != "" {
+		return name
+	}
+	return "keyring"
+}
+
+func secretKey(conn Connection) string {
+	return fmt.Sprintf("qgo:%s", conn.Name)
+}
+
+// StoreSecret moves conn.Password into the active secret backend, leaving
+// only a SecretRef behind. The "plaintext" backend is the one exception: it
+// keeps the password in the config file as-is, for CI environments with no
+// keyring available.
+func StoreSecret(conn *Connection) error {
+	return storeSecretIn(conn, defaultSecretBackend())
+}
+
+func storeSecretIn(conn *Connection, backendName string) error {
+	if conn.Password == "" {
+		return nil
+	}
+
+	backend, err := secretBackend(backendName)
+	if err != nil {
+		return err
+	}
+
+	if backendName == "plaintext" {
+		conn.Secret = SecretRef{Backend: backendName}
+		return nil
+	}
+
+	key := secretKey(*conn)
+	if err := backend.Store(key, conn.Password); err != nil {
+		return err
+	}
+
+	conn.Secret = SecretRef{Backend: backendName, Key: key}
+	conn.Password = ""
+	return nil
+}
+
+// ResolvePassword returns conn's real password, resolving it from its
+// secret backend if it has one. It is meant to be called lazily, right
+// before database.Connect, so the plaintext only ever exists in memory.
+func ResolvePassword(conn Connection) (string, error) {
+	if conn.Secret.Backend == "" || conn.Secret.Backend == "plaintext" {
+		return conn.Password, nil
+	}
+
+	backend, err := secretBackend(conn.Secret.Backend)
+	if err != nil {
+		return "", err
+	}
+
+	return backend.Resolve(conn.Secret.Key)
+}
+
+// RotatePassword stores newPassword for the connection named connName under
+// its configured backend (or the current default, if it has none yet),
+// updates cfg in place and persists it.
+func RotatePassword(cfg Config, connName, newPassword string) (Config, error) {
+	for i, conn := range cfg.Connections {
+		if conn.Name != connName {
+			continue
+		}
+
+		backendName := conn.Secret.Backend
+		if backendName == "" {
+			backendName = defaultSecretBackend()
+		}
+
+		conn.Password = newPassword
+		conn.Secret = SecretRef{}
+		if err := storeSecretIn(&conn, backendName); err != nil {
+			return cfg, fmt.Errorf("storing rotated password: %w", err)
+		}
+
+		cfg.Connections[i] = conn
+		if cfg.CurrentConnection.Name == connName {
+			cfg.CurrentConnection = conn
+		}
+
+		if err := SaveConfig(cfg); err != nil {
+			return cfg, fmt.Errorf("saving config after rotating password: %w", err)
+		}
+
+		return cfg, nil
+	}
+
+	return cfg, fmt.Errorf("no connection named %q", connName)
+}
+
+// migrateLegacyPasswords moves any plaintext password left over from before
+// secret backends existed into the default backend, rewriting cfg to disk
+// if anything changed.
+func migrateLegacyPasswords(cfg Config) (Config, error) {
+	migrated := false
+
+	for i, conn := range cfg.Connections {
+		if conn.Password == "" || conn.Secret.Backend != "" {
+			continue
+		}
+
+		if err := StoreSecret(&conn); err != nil {
+			return cfg, fmt.Errorf("migrating password for connection %q: %w", conn.Name, err)
+		}
+		cfg.Connections[i] = conn
+		if cfg.CurrentConnection.Name == conn.Name {
+			cfg.CurrentConnection = conn
+		}
+		migrated = true
+	}
+
+	if !migrated {
+		return cfg, nil
+	}
+
+	return cfg, SaveConfig(cfg)
+}
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/Ishan-Ravindu/qgo/pkg/utils"
+
+	"filippo.io/age"
+)
+
+func init() {
+	RegisterSecretBackend(&ageBackend{})
+}
+
+// maxAgePassphraseAttempts bounds how many times loadAll will re-prompt for
+// a passphrase that fails to decrypt the secrets file, so a corrupted file
+// fails loudly instead of looping forever.
+const maxAgePassphraseAttempts = 3
+
+var (
+	agePassphraseMu  sync.Mutex
+	agePassphrase    string
+	agePassphraseSet bool
+)
+
+// cachedAgePassphrase prompts for the passphrase protecting the secrets file
+// at most once per process and caches it in memory for the rest of the
+// session. Unlike a sync.Once, a prompt that comes back empty is never
+// cached: it is returned as an error and the next call prompts again, so a
+// stray blank line on stdin can't permanently wedge the backend for the rest
+// of the process.
+func cachedAgePassphrase() (string, error) {
+	agePassphraseMu.Lock()
+	defer agePassphraseMu.Unlock()
+
+	if agePassphraseSet {
+		return agePassphrase, nil
+	}
+
+	fmt.Print("Enter passphrase for encrypted secrets file: ")
+	var pw string
+	fmt.Scanln(&pw)
+	if pw == "" {
+		return "", fmt.Errorf("no passphrase provided")
+	}
+
+	agePassphrase = pw
+	agePassphraseSet = true
+	return agePassphrase, nil
+}
+
+// invalidateAgePassphrase discards a cached passphrase that turned out not
+// to decrypt the secrets file, so the next cachedAgePassphrase call prompts
+// again instead of retrying the same wrong value.
+func invalidateAgePassphrase() {
+	agePassphraseMu.Lock()
+	defer agePassphraseMu.Unlock()
+	agePassphrase = ""
+	agePassphraseSet = false
+}
+
+// ageBackend stores every secret together in a single age-encrypted file,
+// protected by a passphrase prompted once per session, for users without
+// access to an OS keyring.
+type ageBackend struct {
+	mu sync.Mutex
+}
+
+func (b *ageBackend) Name() string { return "age" }
+
+func (b *ageBackend) Store(key, value string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	secrets, err := b.loadAll()
+	if err != nil {
+		return err
+	}
+
+	secrets[key] = value
+	return b.saveAll(secrets)
+}
+
+func (b *ageBackend) Resolve(key string) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	secrets, err := b.loadAll()
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := secrets[key]
+	if !ok {
+		return "", fmt.Errorf("no secret stored for key %q", key)
+	}
+	return value, nil
+}
+
+func (b *ageBackend) loadAll() (map[string]string, error) {
+	data, err := os.ReadFile(utils.GetSecretsPath())
+	if os.IsNotExist(err) {
+		return make(map[string]string), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < maxAgePassphraseAttempts; attempt++ {
+		passphrase, err := cachedAgePassphrase()
+		if err != nil {
+			return nil, err
+		}
+
+		identity, err := age.NewScryptIdentity(passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := age.Decrypt(bytes.NewReader(data), identity)
+		if err != nil {
+			// A decrypt failure means the cached passphrase was wrong, not
+			// that the file is unreadable: drop it and prompt again rather
+			// than retrying the same value or wedging for the rest of the
+			// process.
+			invalidateAgePassphrase()
+			lastErr = fmt.Errorf("decrypting secrets file: %w", err)
+			fmt.Println("Incorrect passphrase.")
+			continue
+		}
+
+		secrets := make(map[string]string)
+		if err := json.NewDecoder(r).Decode(&secrets); err != nil {
+			return nil, err
+		}
+		return secrets, nil
+	}
+
+	return nil, lastErr
+}
+
+func (b *ageBackend) saveAll(secrets map[string]string) error {
+	passphrase, err := cachedAgePassphrase()
+	if err != nil {
+		return err
+	}
+
+	recipient, err := age.NewScryptRecipient(passphrase)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, recipient)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(w).Encode(secrets); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return os.WriteFile(utils.GetSecretsPath(), buf.Bytes(), 0o600)
+}
@@ -0,0 +1,25 @@
+package config
+
+import "github.com/zalando/go-keyring"
+
+func init() {
+	RegisterSecretBackend(keyringBackend{})
+}
+
+// keyringService namespaces qgo's entries in the OS keyring / Secret Service.
+const keyringService = "qgo"
+
+// keyringBackend stores secrets in the OS keyring: Keychain on macOS,
+// Credential Manager on Windows, Secret Service (e.g. gnome-keyring) on
+// Linux. It is the default backend.
+type keyringBackend struct{}
+
+func (keyringBackend) Name() string { return "keyring" }
+
+func (keyringBackend) Store(key, value string) error {
+	return keyring.Set(keyringService, key, value)
+}
+
+func (keyringBackend) Resolve(key string) (string, error) {
+	return keyring.Get(keyringService, key)
+}
@@ -0,0 +1,16 @@
+package config
+
+func init() {
+	RegisterSecretBackend(plaintextBackend{})
+}
+
+// plaintextBackend leaves passwords in the config file untouched. It exists
+// for CI environments where no OS keyring or interactive passphrase prompt
+// is available.
+type plaintextBackend struct{}
+
+func (plaintextBackend) Name() string { return "plaintext" }
+
+func (plaintextBackend) Store(key, value string) error { return nil }
+
+func (plaintextBackend) Resolve(key string) (string, error) { return "", nil }
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/Ishan-Ravindu/qgo/internal/config"
+)
+
+// ForeignKey describes a single foreign-key relationship, used to suggest
+// join predicates in the completer.
+type ForeignKey struct {
+	Table     string
+	Column    string
+	RefTable  string
+	RefColumn string
+}
+
+// Driver adapts a specific database engine to qgo: how to reach it, how to
+// open it, and how to discover its schema. New engines are added by
+// implementing Driver and calling Register from an init() function, rather
+// than by touching the switch statements that used to live here.
+type Driver interface {
+	// DriverName is the name registered with database/sql (e.g. "mysql").
+	DriverName() string
+	// DSN builds the data source name/connection string for conn.
+	DSN(conn config.Connection) string
+	// Fields lists the connection fields this driver needs, in prompt order.
+	Fields() []config.Field
+	// ListTables returns the names of the user tables visible on db.
+	ListTables(db *sql.DB) ([]string, error)
+	// ListColumns returns the column names of table.
+	ListColumns(db *sql.DB, table string) ([]string, error)
+	// ListForeignKeys returns every foreign-key relationship in the database.
+	ListForeignKeys(db *sql.DB) ([]ForeignKey, error)
+	// QuoteIdent quotes name as an identifier for this driver's SQL dialect.
+	QuoteIdent(name string) string
+}
+
+var drivers = make(map[string]Driver)
+
+// Register makes a Driver available under name (config.Connection.Type).
+// It is meant to be called from an init() function in the driver's own file.
+func Register(name string, d Driver) {
+	drivers[name] = d
+}
+
+// Lookup returns the Driver registered for name, or an error if none was.
+func Lookup(name string) (Driver, error) {
+	d, ok := drivers[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported database type: %s", name)
+	}
+	return d, nil
+}
+
+// RegisteredNames returns the type names of every registered driver.
+func RegisteredNames() []string {
+	names := make([]string, 0, len(drivers))
+	for name := range drivers {
+		names = append(names, name)
+	}
+	return names
+}
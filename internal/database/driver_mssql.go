@@ -0,0 +1,114 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/Ishan-Ravindu/qgo/internal/config"
+
+	_ "github.com/microsoft/go-mssqldb"
+)
+
+func init() {
+	Register("mssql", mssqlDriver{})
+}
+
+type mssqlDriver struct{}
+
+func (mssqlDriver) DriverName() string { return "sqlserver" }
+
+func (mssqlDriver) DSN(conn config.Connection) string {
+	host := conn.Host
+	if conn.Instance != "" {
+		host = fmt.Sprintf("%s\\%s", host, conn.Instance)
+	}
+
+	encrypt := conn.Encrypt
+	if encrypt == "" {
+		encrypt = "disable"
+	}
+
+	return fmt.Sprintf("sqlserver://%s:%s@%s:%s?database=%s&encrypt=%s",
+		conn.User, conn.Password, host, conn.Port, conn.Database, encrypt)
+}
+
+func (mssqlDriver) Fields() []config.Field {
+	return []config.Field{
+		{Key: "Host", Label: "Enter host"},
+		{Key: "Port", Label: "Enter port"},
+		{Key: "Instance", Label: "Enter instance name (optional)"},
+		{Key: "User", Label: "Enter username"},
+		{Key: "Password", Label: "Enter password", Secret: true},
+		{Key: "Database", Label: "Enter database name"},
+		{Key: "Encrypt", Label: "Enter encrypt mode (disable/true/false, optional)"},
+	}
+}
+
+func (mssqlDriver) ListTables(db *sql.DB) ([]string, error) {
+	rows, err := db.Query("SELECT table_name FROM information_schema.tables WHERE table_type = 'BASE TABLE'")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+
+	return tables, nil
+}
+
+func (mssqlDriver) ListColumns(db *sql.DB, table string) ([]string, error) {
+	rows, err := db.Query("SELECT column_name FROM information_schema.columns WHERE table_name = @p1", table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+func (mssqlDriver) ListForeignKeys(db *sql.DB) ([]ForeignKey, error) {
+	rows, err := db.Query(`
+		SELECT
+			OBJECT_NAME(fk.parent_object_id), pc.name,
+			OBJECT_NAME(fk.referenced_object_id), rc.name
+		FROM sys.foreign_keys fk
+		JOIN sys.foreign_key_columns fkc ON fkc.constraint_object_id = fk.object_id
+		JOIN sys.columns pc ON pc.object_id = fkc.parent_object_id AND pc.column_id = fkc.parent_column_id
+		JOIN sys.columns rc ON rc.object_id = fkc.referenced_object_id AND rc.column_id = fkc.referenced_column_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fks []ForeignKey
+	for rows.Next() {
+		var fk ForeignKey
+		if err := rows.Scan(&fk.Table, &fk.Column, &fk.RefTable, &fk.RefColumn); err != nil {
+			return nil, err
+		}
+		fks = append(fks, fk)
+	}
+
+	return fks, nil
+}
+
+func (mssqlDriver) QuoteIdent(name string) string {
+	return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+}
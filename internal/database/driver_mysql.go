@@ -0,0 +1,97 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/Ishan-Ravindu/qgo/internal/config"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+func init() {
+	Register("mysql", mysqlDriver{})
+}
+
+type mysqlDriver struct{}
+
+func (mysqlDriver) DriverName() string { return "mysql" }
+
+func (mysqlDriver) DSN(conn config.Connection) string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s", conn.User, conn.Password, conn.Host, conn.Port, conn.Database)
+}
+
+func (mysqlDriver) Fields() []config.Field {
+	return []config.Field{
+		{Key: "Host", Label: "Enter host"},
+		{Key: "Port", Label: "Enter port"},
+		{Key: "User", Label: "Enter username"},
+		{Key: "Password", Label: "Enter password", Secret: true},
+		{Key: "Database", Label: "Enter database name"},
+	}
+}
+
+func (mysqlDriver) ListTables(db *sql.DB) ([]string, error) {
+	rows, err := db.Query("SHOW TABLES")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+
+	return tables, nil
+}
+
+func (mysqlDriver) ListColumns(db *sql.DB, table string) ([]string, error) {
+	rows, err := db.Query(fmt.Sprintf("SHOW COLUMNS FROM %s", table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var column string
+		var dummy sql.NullString
+		if err := rows.Scan(&column, &dummy, &dummy, &dummy, &dummy, &dummy); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+func (mysqlDriver) ListForeignKeys(db *sql.DB) ([]ForeignKey, error) {
+	rows, err := db.Query(`
+		SELECT table_name, column_name, referenced_table_name, referenced_column_name
+		FROM information_schema.key_column_usage
+		WHERE table_schema = DATABASE() AND referenced_table_name IS NOT NULL`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fks []ForeignKey
+	for rows.Next() {
+		var fk ForeignKey
+		if err := rows.Scan(&fk.Table, &fk.Column, &fk.RefTable, &fk.RefColumn); err != nil {
+			return nil, err
+		}
+		fks = append(fks, fk)
+	}
+
+	return fks, nil
+}
+
+func (mysqlDriver) QuoteIdent(name string) string {
+	return "`" + name + "`"
+}
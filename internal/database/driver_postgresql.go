@@ -0,0 +1,102 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/Ishan-Ravindu/qgo/internal/config"
+
+	_ "github.com/lib/pq"
+)
+
+func init() {
+	Register("postgresql", postgresDriver{})
+}
+
+type postgresDriver struct{}
+
+func (postgresDriver) DriverName() string { return "postgres" }
+
+func (postgresDriver) DSN(conn config.Connection) string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		conn.Host, conn.Port, conn.User, conn.Password, conn.Database)
+}
+
+func (postgresDriver) Fields() []config.Field {
+	return []config.Field{
+		{Key: "Host", Label: "Enter host"},
+		{Key: "Port", Label: "Enter port"},
+		{Key: "User", Label: "Enter username"},
+		{Key: "Password", Label: "Enter password", Secret: true},
+		{Key: "Database", Label: "Enter database name"},
+	}
+}
+
+func (postgresDriver) ListTables(db *sql.DB) ([]string, error) {
+	rows, err := db.Query("SELECT table_name FROM information_schema.tables WHERE table_schema = 'public'")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+
+	return tables, nil
+}
+
+func (postgresDriver) ListColumns(db *sql.DB, table string) ([]string, error) {
+	rows, err := db.Query("SELECT column_name FROM information_schema.columns WHERE table_name = $1", table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+func (postgresDriver) ListForeignKeys(db *sql.DB) ([]ForeignKey, error) {
+	rows, err := db.Query(`
+		SELECT
+			tc.table_name, kcu.column_name,
+			ccu.table_name AS referenced_table_name, ccu.column_name AS referenced_column_name
+		FROM information_schema.table_constraints tc
+		JOIN information_schema.key_column_usage kcu ON tc.constraint_name = kcu.constraint_name
+		JOIN information_schema.constraint_column_usage ccu ON tc.constraint_name = ccu.constraint_name
+		WHERE tc.constraint_type = 'FOREIGN KEY'`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fks []ForeignKey
+	for rows.Next() {
+		var fk ForeignKey
+		if err := rows.Scan(&fk.Table, &fk.Column, &fk.RefTable, &fk.RefColumn); err != nil {
+			return nil, err
+		}
+		fks = append(fks, fk)
+	}
+
+	return fks, nil
+}
+
+func (postgresDriver) QuoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
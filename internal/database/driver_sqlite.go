@@ -0,0 +1,98 @@
+package database
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/Ishan-Ravindu/qgo/internal/config"
+
+	_ "modernc.org/sqlite"
+)
+
+func init() {
+	Register("sqlite", sqliteDriver{})
+}
+
+type sqliteDriver struct{}
+
+func (sqliteDriver) DriverName() string { return "sqlite" }
+
+// DSN is simply the path to the database file; SQLite needs nothing else.
+func (sqliteDriver) DSN(conn config.Connection) string {
+	return conn.Database
+}
+
+func (sqliteDriver) Fields() []config.Field {
+	return []config.Field{
+		{Key: "Database", Label: "Enter path to SQLite file"},
+	}
+}
+
+func (sqliteDriver) ListTables(db *sql.DB) ([]string, error) {
+	rows, err := db.Query("SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+
+	return tables, nil
+}
+
+func (sqliteDriver) ListColumns(db *sql.DB, table string) ([]string, error) {
+	rows, err := db.Query("SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+func (d sqliteDriver) ListForeignKeys(db *sql.DB) ([]ForeignKey, error) {
+	tables, err := d.ListTables(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var fks []ForeignKey
+	for _, table := range tables {
+		rows, err := db.Query("SELECT \"table\", \"from\", \"to\" FROM pragma_foreign_key_list(?)", table)
+		if err != nil {
+			return nil, err
+		}
+
+		for rows.Next() {
+			fk := ForeignKey{Table: table}
+			if err := rows.Scan(&fk.RefTable, &fk.Column, &fk.RefColumn); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			fks = append(fks, fk)
+		}
+		rows.Close()
+	}
+
+	return fks, nil
+}
+
+func (sqliteDriver) QuoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,26 @@
+package database
+
+import "testing"
+
+func TestQuoteIdent(t *testing.T) {
+	tests := []struct {
+		driver Driver
+		name   string
+		input  string
+		want   string
+	}{
+		{mysqlDriver{}, "mysql", "users", "`users`"},
+		{postgresDriver{}, "postgresql", "users", `"users"`},
+		{postgresDriver{}, "postgresql", `we"ird`, `"we""ird"`},
+		{sqliteDriver{}, "sqlite", "users", `"users"`},
+		{sqliteDriver{}, "sqlite", `we"ird`, `"we""ird"`},
+		{mssqlDriver{}, "mssql", "users", "[users]"},
+		{mssqlDriver{}, "mssql", "we]ird", "[we]]ird]"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.driver.QuoteIdent(tt.input); got != tt.want {
+			t.Errorf("%s.QuoteIdent(%q) = %q, want %q", tt.name, tt.input, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Package sqlparse wraps real SQL parsers so the rest of qgo can reason
+// about a query's structure instead of guessing from its first keyword.
+package sqlparse
+
+// TableRef is one table referenced in a query's FROM/JOIN clause, together
+// with the alias it was given (if any).
+type TableRef struct {
+	Name  string
+	Alias string
+}
+
+// Statement is the part of a parsed query qgo cares about: whether it is a
+// read-only SELECT, and which tables it touches.
+type Statement struct {
+	IsSelect bool
+	Tables   []TableRef
+}
+
+// Parse parses query and classifies it. dialect is a config.Connection.Type
+// value (e.g. "mysql", "postgresql", "sqlite", "mssql"). mysql and
+// postgresql each get a real, dialect-specific parser; sqlite and mssql
+// have none available, so Parse skips structural validation entirely for
+// them and reports the query as a SELECT unconditionally, leaving any write
+// to the caller's transaction (read-only for sqlite, not for mssql, and
+// rolled back in both cases). A non-nil error means the SQL did not parse at all (e.g. it is
+// incomplete, as happens while the user is still typing); a parsed-but-non-
+// SELECT statement is reported via Statement.IsSelect, not an error.
+func Parse(query, dialect string) (*Statement, error) {
+	switch dialect {
+	case "mysql":
+		return parseMySQL(query)
+	case "postgresql":
+		return parsePostgres(query)
+	default:
+		return &Statement{IsSelect: true}, nil
+	}
+}
@@ -0,0 +1,64 @@
+package sqlparse
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// mysqlParser is a single shared vitess parser instance; New only fails on
+// an invalid MySQLServerVersion, and Options{} always supplies the default.
+var mysqlParser = func() *sqlparser.Parser {
+	p, err := sqlparser.New(sqlparser.Options{})
+	if err != nil {
+		panic(err)
+	}
+	return p
+}()
+
+func parseMySQL(query string) (*Statement, error) {
+	stmt, err := mysqlParser.Parse(query)
+	if err != nil {
+		return nil, fmt.Errorf("parsing query: %w", err)
+	}
+
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return &Statement{IsSelect: false}, nil
+	}
+
+	return &Statement{
+		IsSelect: true,
+		Tables:   extractMySQLTables(sel.From),
+	}, nil
+}
+
+func extractMySQLTables(exprs sqlparser.TableExprs) []TableRef {
+	var refs []TableRef
+	for _, expr := range exprs {
+		refs = append(refs, extractFromMySQLTableExpr(expr)...)
+	}
+	return refs
+}
+
+func extractFromMySQLTableExpr(expr sqlparser.TableExpr) []TableRef {
+	switch e := expr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		name, ok := e.Expr.(sqlparser.TableName)
+		if !ok {
+			return nil
+		}
+		return []TableRef{{
+			Name:  name.Name.String(),
+			Alias: e.As.String(),
+		}}
+	case *sqlparser.JoinTableExpr:
+		refs := extractFromMySQLTableExpr(e.LeftExpr)
+		refs = append(refs, extractFromMySQLTableExpr(e.RightExpr)...)
+		return refs
+	case *sqlparser.ParenTableExpr:
+		return extractMySQLTables(e.Exprs)
+	default:
+		return nil
+	}
+}
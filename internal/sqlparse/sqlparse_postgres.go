@@ -0,0 +1,57 @@
+package sqlparse
+
+import (
+	"fmt"
+
+	pg_query "github.com/pganalyze/pg_query_go/v6"
+)
+
+func parsePostgres(query string) (*Statement, error) {
+	result, err := pg_query.Parse(query)
+	if err != nil {
+		return nil, fmt.Errorf("parsing query: %w", err)
+	}
+
+	if len(result.Stmts) != 1 {
+		return &Statement{IsSelect: false}, nil
+	}
+
+	sel := result.Stmts[0].Stmt.GetSelectStmt()
+	if sel == nil {
+		return &Statement{IsSelect: false}, nil
+	}
+
+	return &Statement{
+		IsSelect: true,
+		Tables:   extractPostgresTables(sel.FromClause),
+	}, nil
+}
+
+func extractPostgresTables(nodes []*pg_query.Node) []TableRef {
+	var refs []TableRef
+	for _, node := range nodes {
+		refs = append(refs, extractFromPostgresNode(node)...)
+	}
+	return refs
+}
+
+func extractFromPostgresNode(node *pg_query.Node) []TableRef {
+	switch {
+	case node.GetRangeVar() != nil:
+		rv := node.GetRangeVar()
+		alias := ""
+		if rv.Alias != nil {
+			alias = rv.Alias.Aliasname
+		}
+		return []TableRef{{Name: rv.Relname, Alias: alias}}
+	case node.GetJoinExpr() != nil:
+		je := node.GetJoinExpr()
+		refs := extractFromPostgresNode(je.Larg)
+		refs = append(refs, extractFromPostgresNode(je.Rarg)...)
+		return refs
+	case node.GetRangeSubselect() != nil:
+		return nil
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,77 @@
+package sqlparse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMySQLSelect(t *testing.T) {
+	stmt, err := Parse("SELECT u.id FROM users u JOIN orders o ON o.user_id = u.id", "mysql")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if !stmt.IsSelect {
+		t.Fatal("expected IsSelect to be true")
+	}
+
+	want := []TableRef{{Name: "users", Alias: "u"}, {Name: "orders", Alias: "o"}}
+	if !reflect.DeepEqual(stmt.Tables, want) {
+		t.Errorf("Tables = %+v, want %+v", stmt.Tables, want)
+	}
+}
+
+func TestParseMySQLNonSelect(t *testing.T) {
+	stmt, err := Parse("DELETE FROM users WHERE id = 1", "mysql")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if stmt.IsSelect {
+		t.Fatal("expected IsSelect to be false for a DELETE statement")
+	}
+}
+
+func TestParseMySQLInvalidSyntax(t *testing.T) {
+	if _, err := Parse("SELEC * FROM users", "mysql"); err == nil {
+		t.Fatal("expected an error for invalid SQL")
+	}
+}
+
+func TestParsePostgresSelect(t *testing.T) {
+	stmt, err := Parse("SELECT u.id FROM users u JOIN orders o ON o.user_id = u.id", "postgresql")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if !stmt.IsSelect {
+		t.Fatal("expected IsSelect to be true")
+	}
+
+	want := []TableRef{{Name: "users", Alias: "u"}, {Name: "orders", Alias: "o"}}
+	if !reflect.DeepEqual(stmt.Tables, want) {
+		t.Errorf("Tables = %+v, want %+v", stmt.Tables, want)
+	}
+}
+
+func TestParsePostgresNonSelect(t *testing.T) {
+	stmt, err := Parse("DELETE FROM users WHERE id = 1", "postgresql")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if stmt.IsSelect {
+		t.Fatal("expected IsSelect to be false for a DELETE statement")
+	}
+}
+
+func TestParseSkipsValidationForUnsupportedDialects(t *testing.T) {
+	for _, dialect := range []string{"sqlite", "mssql"} {
+		stmt, err := Parse("this is not valid SQL at all", dialect)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned error: %v", dialect, err)
+		}
+		if !stmt.IsSelect {
+			t.Errorf("Parse(%q) IsSelect = false, want true (validation skipped)", dialect)
+		}
+		if stmt.Tables != nil {
+			t.Errorf("Parse(%q) Tables = %+v, want nil", dialect, stmt.Tables)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+
+	"golang.org/x/term"
+)
+
+// ReadPassword prints label, then reads a line from stdin without echoing
+// it to the terminal, for secret-bearing fields like passwords.
+func ReadPassword(label string) (string, error) {
+	fmt.Print(label)
+	pw, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	if err != nil {
+		return "", fmt.Errorf("reading password: %w", err)
+	}
+	return string(pw), nil
+}
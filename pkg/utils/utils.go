@@ -11,4 +11,12 @@ func GetConfigPath() string {
 		panic(err)
 	}
 	return filepath.Join(homeDir, ".qgo_config.json")
-}
\ No newline at end of file
+}
+
+func GetSecretsPath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		panic(err)
+	}
+	return filepath.Join(homeDir, ".qgo_secrets.age")
+}